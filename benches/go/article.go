@@ -0,0 +1,187 @@
+package main
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	readability "github.com/philipjkim/goreadability"
+)
+
+// Article is a richer extraction result than the bare
+// readability.Content, matching the shape used by
+// go-shiori/go-readability so callers get a consistent set of fields
+// regardless of backend. The goreadability backend only gets a single
+// content-bearing field out of the library (Description), so its
+// Excerpt is a short summary derived from Content rather than a
+// separately extracted dek the way go-shiori's is.
+type Article struct {
+	Title       string
+	Byline      string
+	Excerpt     string
+	SiteName    string
+	Content     string
+	TextContent string
+	Length      int
+	Metrics     Metrics
+}
+
+var whitespaceRe = regexp.MustCompile(`\s+`)
+
+// buildArticle gathers metadata that readability.Content doesn't
+// surface on its own (author, site name, plain text) and assembles an
+// Article from it plus the already-extracted content. Content only
+// exposes Title, Description, Author and Images, so Description
+// stands in for the cleaned content, Excerpt is summarized from it,
+// and JSON-LD/meta tags fill the title and description in when the
+// library came back empty-handed.
+func buildArticle(document *goquery.Document, content *readability.Content) *Article {
+	ld := jsonLDMeta(document)
+
+	title := content.Title
+	if title == "" {
+		title = ld.Headline
+	}
+
+	body := content.Description
+	if body == "" {
+		body = ld.Description
+	}
+
+	text := plainText(body)
+
+	return &Article{
+		Title:       title,
+		Byline:      byline(document, content.Author, ld.Author),
+		Excerpt:     summarize(text, excerptRuneLimit),
+		SiteName:    siteName(document),
+		Content:     body,
+		TextContent: text,
+		Length:      len([]rune(text)),
+		Metrics:     ComputeMetrics(text),
+	}
+}
+
+// excerptRuneLimit bounds the summary returned by summarize, matching
+// the rough length of the deks go-shiori's own Excerpt field produces.
+const excerptRuneLimit = 200
+
+// summarize returns a short, word-boundary-safe prefix of text for use
+// as an excerpt, appending "…" when it had to cut the text short.
+func summarize(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+
+	prefix := string(runes[:limit])
+	cut := strings.LastIndexAny(prefix, " \t\n")
+	if cut <= 0 {
+		cut = len(prefix)
+	}
+
+	return strings.TrimSpace(prefix[:cut]) + "…"
+}
+
+// byline looks for an author in the usual places, in order: the
+// standard <meta name="author">, the OpenGraph article:author
+// property, JSON-LD NewsArticle/Article blocks, and finally whatever
+// readability.Content itself found.
+func byline(document *goquery.Document, contentAuthor, jsonLDAuthor string) string {
+	if author, ok := document.Find(`meta[name="author"]`).First().Attr("content"); ok && author != "" {
+		return strings.TrimSpace(author)
+	}
+
+	if author, ok := document.Find(`meta[property="article:author"]`).First().Attr("content"); ok && author != "" {
+		return strings.TrimSpace(author)
+	}
+
+	if jsonLDAuthor != "" {
+		return jsonLDAuthor
+	}
+
+	return strings.TrimSpace(contentAuthor)
+}
+
+// siteName reads the OpenGraph og:site_name property.
+func siteName(document *goquery.Document) string {
+	name, _ := document.Find(`meta[property="og:site_name"]`).First().Attr("content")
+	return strings.TrimSpace(name)
+}
+
+// ldMeta is the subset of a JSON-LD NewsArticle/Article node that
+// feeds Article's metadata.
+type ldMeta struct {
+	Author      string
+	Headline    string
+	Description string
+}
+
+type jsonLDNode struct {
+	Type        string      `json:"@type"`
+	Author      interface{} `json:"author"`
+	Headline    string      `json:"headline"`
+	Description string      `json:"description"`
+}
+
+// jsonLDMeta scans <script type="application/ld+json"> blocks for the
+// first NewsArticle or Article node and pulls its author, headline and
+// description out, so they can fill gaps left by meta tags and the
+// extractor itself.
+func jsonLDMeta(document *goquery.Document) ldMeta {
+	var meta ldMeta
+
+	document.Find(`script[type="application/ld+json"]`).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		var node jsonLDNode
+		if err := json.Unmarshal([]byte(s.Text()), &node); err != nil {
+			return true
+		}
+
+		if node.Type != "NewsArticle" && node.Type != "Article" {
+			return true
+		}
+
+		meta = ldMeta{
+			Author:      authorName(node.Author),
+			Headline:    strings.TrimSpace(node.Headline),
+			Description: strings.TrimSpace(node.Description),
+		}
+
+		return false
+	})
+
+	return meta
+}
+
+// authorName normalizes the author field of a JSON-LD node, which may
+// be a bare string or a Person/Organization object with a "name".
+func authorName(raw interface{}) string {
+	switch v := raw.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok {
+			return strings.TrimSpace(name)
+		}
+	}
+
+	return ""
+}
+
+// plainText parses an HTML-or-plain string (readability.Content's
+// Description is either, depending on DescriptionAsPlainText) and
+// returns its text content with runs of whitespace collapsed to a
+// single space.
+func plainText(htmlOrText string) string {
+	if htmlOrText == "" {
+		return ""
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlOrText))
+	if err != nil {
+		return whitespaceRe.ReplaceAllString(strings.TrimSpace(htmlOrText), " ")
+	}
+
+	return whitespaceRe.ReplaceAllString(strings.TrimSpace(doc.Text()), " ")
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	readability "github.com/philipjkim/goreadability"
+	"golang.org/x/net/html"
+)
+
+// Input identifies a single source to extract: either a URL to fetch
+// or the path of an HTML file to read from disk.
+type Input struct {
+	URL      string
+	FilePath string
+}
+
+// BatchOptions configures BatchExtract.
+type BatchOptions struct {
+	// Concurrency is the number of worker goroutines. Defaults to
+	// runtime.NumCPU() when zero.
+	Concurrency int
+
+	// Timeout is applied to each item through a context.Context. For a
+	// URL input, the time left before the deadline becomes the HTTP
+	// client's timeout, and an item whose context is already done is
+	// not started. Reading a local file and the extraction itself are
+	// not interrupted when the deadline passes.
+	Timeout time.Duration
+
+	// ReadabilityOption is passed through to the underlying extractor.
+	ReadabilityOption *readability.Option
+
+	// Stream, if non-nil, receives every Result as it completes, in
+	// addition to it being included in BatchExtract's return value.
+	// Callers that only want the stream should drain it concurrently,
+	// since BatchExtract still blocks until all inputs are done.
+	Stream chan<- Result
+}
+
+// Result is the outcome of extracting a single Input.
+type Result struct {
+	Input   Input
+	Article *Article
+	Err     error
+	Elapsed time.Duration
+}
+
+// BatchExtract runs ExtractFromDocument over inputs concurrently using
+// a pool of opts.Concurrency workers, applying opts.Timeout to each
+// item via context.Context. Results are returned in the same order as
+// inputs regardless of completion order.
+func BatchExtract(inputs []Input, opts BatchOptions) []Result {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+
+	results := make([]Result, len(inputs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				result := extractOne(inputs[index], opts)
+				results[index] = result
+
+				if opts.Stream != nil {
+					opts.Stream <- result
+				}
+			}
+		}()
+	}
+
+	for i := range inputs {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	if opts.Stream != nil {
+		close(opts.Stream)
+	}
+
+	return results
+}
+
+// extractOne extracts a single Input under a context carrying
+// opts.Timeout.
+func extractOne(input Input, opts BatchOptions) Result {
+	ctx := context.Background()
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	article, err := extractInput(ctx, input, opts.ReadabilityOption)
+	elapsed := time.Since(start)
+
+	return Result{
+		Input:   input,
+		Article: article,
+		Err:     err,
+		Elapsed: elapsed,
+	}
+}
+
+// extractInput dispatches to a URL fetch or a local file read,
+// bailing out early if ctx is done before extraction starts.
+func extractInput(ctx context.Context, input Input, opt *readability.Option) (*Article, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var document *goquery.Document
+	var source string
+
+	if input.URL != "" {
+		timeout := time.Duration(0)
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = time.Until(deadline)
+		}
+
+		doc, finalURL, err := fetchDocument(input.URL, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		document, source = doc, finalURL
+	} else {
+		file, err := os.Open(input.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("can't open %s: %w", input.FilePath, err)
+		}
+		defer file.Close()
+
+		parse_opts := html.ParseOptionEnableScripting(false)
+		node, err := html.ParseWithOptions(file, parse_opts)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse %s: %w", input.FilePath, err)
+		}
+
+		document, source = goquery.NewDocumentFromNode(node), input.FilePath
+	}
+
+	if opt == nil {
+		opt = readability.NewOption()
+	}
+
+	content, err := readability.ExtractFromDocument(document, source, opt)
+	if err != nil {
+		return nil, fmt.Errorf("can't extract article from %s: %w", source, err)
+	}
+
+	return buildArticle(document, content), nil
+}
@@ -0,0 +1,80 @@
+package main
+
+// dalechallFamiliarWords is the list of "familiar" words used by the
+// Dale-Chall readability formula: a word not on this list counts as
+// difficult. The full published Dale-Chall list runs to about 3000
+// entries; this is a ~600-word subset of the most common ones. A
+// smaller familiar list means more words get flagged as difficult, so
+// DaleChall scores computed here will read somewhat harder than the
+// canonical formula on the same text.
+var dalechallFamiliarWords = buildFamiliarWordSet([]string{
+	"a", "able", "about", "above", "across", "act", "add", "afraid", "after", "again",
+	"age", "ago", "agree", "air", "all", "almost", "alone", "along", "already", "also",
+	"always", "am", "among", "an", "and", "animal", "another", "answer", "any", "anyone",
+	"anything", "appear", "are", "area", "arm", "around", "arrive", "art", "as", "ask",
+	"at", "away", "baby", "back", "bad", "bag", "ball", "bank", "base", "be",
+	"bear", "beautiful", "became", "because", "become", "bed", "been", "before", "began", "begin",
+	"behind", "believe", "bell", "belong", "below", "beside", "best", "better", "between", "big",
+	"bird", "bit", "black", "blue", "boat", "body", "book", "born", "both", "bottom",
+	"box", "boy", "break", "bright", "bring", "brother", "brought", "build", "built", "business",
+	"but", "buy", "by", "call", "came", "can", "car", "care", "carry", "case",
+	"catch", "caught", "cause", "center", "certain", "chair", "chance", "change", "check", "child",
+	"children", "choose", "city", "class", "clean", "clear", "close", "cold", "color", "come",
+	"common", "company", "complete", "consider", "continue", "control", "cook", "cool", "corner", "could",
+	"country", "course", "cover", "cry", "cut", "dark", "day", "dead", "dear", "deep",
+	"did", "die", "different", "difficult", "dinner", "do", "does", "dog", "done", "door",
+	"down", "draw", "dream", "dress", "drink", "drive", "drop", "dry", "during", "each",
+	"early", "earth", "easy", "eat", "egg", "eight", "either", "else", "end", "enjoy",
+	"enough", "enter", "even", "ever", "every", "everyone", "everything", "example", "eye", "face",
+	"fact", "fall", "family", "far", "farm", "fast", "father", "fear", "feel", "feet",
+	"fell", "felt", "few", "field", "fight", "fill", "final", "find", "fine", "finger",
+	"finish", "fire", "first", "fish", "five", "fly", "follow", "food", "foot", "for",
+	"force", "forget", "form", "found", "four", "free", "friend", "from", "front", "full",
+	"game", "garden", "gave", "get", "girl", "give", "given", "glad", "go", "gone",
+	"good", "got", "great", "green", "grew", "ground", "group", "grow", "hair", "half",
+	"hand", "happen", "happy", "hard", "has", "have", "he", "head", "hear", "heard",
+	"heart", "heavy", "help", "her", "here", "herself", "high", "hill", "him", "himself",
+	"his", "hold", "home", "hope", "horse", "hot", "hour", "house", "how", "however",
+	"hundred", "idea", "if", "important", "in", "inside", "instead", "into", "is", "it",
+	"its", "itself", "job", "join", "jump", "just", "keep", "kept", "kind", "king",
+	"knew", "know", "known", "land", "large", "last", "late", "later", "laugh", "lay",
+	"lead", "learn", "least", "leave", "left", "leg", "less", "let", "letter", "life",
+	"light", "like", "line", "list", "listen", "little", "live", "long", "look", "lost",
+	"lot", "love", "low", "made", "make", "man", "many", "matter", "may", "maybe",
+	"me", "mean", "meet", "men", "might", "mile", "mind", "mine", "minute", "miss",
+	"moment", "money", "month", "more", "morning", "most", "mother", "mountain", "mouth", "move",
+	"much", "music", "must", "my", "myself", "name", "near", "need", "never", "new",
+	"next", "night", "nine", "no", "nor", "north", "not", "nothing", "notice", "now",
+	"number", "of", "off", "offer", "office", "often", "oh", "old", "on", "once",
+	"one", "only", "open", "or", "order", "other", "our", "out", "over", "own",
+	"page", "paper", "part", "pass", "past", "people", "perhaps", "person", "picture", "piece",
+	"place", "plan", "plant", "play", "point", "poor", "possible", "present", "pretty", "probably",
+	"problem", "put", "question", "quick", "quiet", "quite", "rain", "ran", "reach", "read",
+	"ready", "real", "really", "reason", "red", "remember", "rest", "return", "ride", "right",
+	"river", "road", "room", "round", "run", "said", "same", "sat", "saw", "say",
+	"school", "sea", "season", "second", "see", "seem", "seen", "sense", "sent", "set",
+	"seven", "several", "shall", "she", "short", "should", "show", "side", "simple", "since",
+	"sing", "sister", "sit", "six", "sky", "sleep", "small", "smile", "so", "some",
+	"someone", "something", "sometimes", "son", "song", "soon", "sound", "speak", "special", "stand",
+	"start", "state", "stay", "still", "stood", "stop", "story", "street", "strong", "such",
+	"sun", "sure", "table", "take", "talk", "tell", "ten", "than", "that", "the",
+	"their", "them", "then", "there", "these", "they", "thing", "think", "third", "this",
+	"those", "though", "thought", "three", "through", "time", "to", "today", "together", "told",
+	"too", "took", "top", "toward", "town", "tree", "true", "try", "turn", "two",
+	"under", "understand", "until", "up", "upon", "us", "use", "used", "very", "voice",
+	"wait", "walk", "want", "warm", "was", "watch", "water", "way", "we", "wear",
+	"week", "well", "went", "were", "what", "when", "where", "whether", "which", "while",
+	"white", "who", "whole", "whose", "why", "wide", "wife", "will", "wind", "window",
+	"wish", "with", "within", "without", "woman", "word", "work", "world", "would", "write",
+	"wrote", "year", "yes", "yet", "you", "young", "your", "yourself",
+})
+
+// buildFamiliarWordSet turns a word list into a lookup set.
+func buildFamiliarWordSet(words []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		set[w] = struct{}{}
+	}
+
+	return set
+}
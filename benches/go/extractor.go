@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/PuerkitoBio/goquery"
+	readability "github.com/philipjkim/goreadability"
+)
+
+// Options carries the extraction knobs that matter across backends.
+// goreadabilityExtractor translates it into a readability.Option;
+// shioriExtractor does not use it.
+type Options struct {
+	LookupOpenGraphTags bool
+}
+
+// Extractor is implemented by each extraction backend so the harness
+// can swap between them without touching call sites.
+type Extractor interface {
+	Extract(doc *goquery.Document, url string, opt *Options) (*Article, error)
+}
+
+// extractors lists the available backends by name, for use with the
+// -backend flag.
+var extractors = map[string]Extractor{
+	"goreadability": goreadabilityExtractor{},
+	"go-shiori":     shioriExtractor{},
+}
+
+// goreadabilityExtractor extracts using philipjkim/goreadability, the
+// backend the rest of this harness is built around.
+type goreadabilityExtractor struct{}
+
+func (goreadabilityExtractor) Extract(doc *goquery.Document, url string, opt *Options) (*Article, error) {
+	goOpt := readability.NewOption()
+	if opt != nil {
+		goOpt.LookupOpenGraphTags = opt.LookupOpenGraphTags
+	}
+
+	content, err := readability.ExtractFromDocument(doc, url, goOpt)
+	if err != nil {
+		return nil, fmt.Errorf("can't extract article from %s: %w", url, err)
+	}
+
+	return buildArticle(doc, content), nil
+}
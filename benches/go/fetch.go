@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	readability "github.com/philipjkim/goreadability"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+)
+
+// FetchResult carries the outcome of fetchAndExtract: the extracted
+// content along with the final URL the request landed on after
+// following redirects, which the extractor needs to resolve relative
+// links correctly.
+type FetchResult struct {
+	FinalURL string
+	Content  *readability.Content
+	Elapsed  time.Duration
+}
+
+// fetchDocument performs an HTTP GET against target, transcoding the
+// response body to UTF-8 based on the declared Content-Type/meta
+// charset, and returns the parsed document along with the URL the
+// request finally landed on after following redirects.
+func fetchDocument(target string, timeout time.Duration) (document *goquery.Document, finalURL string, err error) {
+	client := &http.Client{Timeout: timeout}
+
+	resp, err := client.Get(target)
+	if err != nil {
+		return nil, "", fmt.Errorf("can't fetch %s: %w", target, err)
+	}
+	defer resp.Body.Close()
+
+	finalURL = target
+	if resp.Request != nil && resp.Request.URL != nil {
+		finalURL = resp.Request.URL.String()
+	}
+
+	if _, err := url.Parse(finalURL); err != nil {
+		return nil, "", fmt.Errorf("invalid final url %s: %w", finalURL, err)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	reader, err := charset.NewReader(resp.Body, contentType)
+	if err != nil {
+		return nil, "", fmt.Errorf("can't detect charset for %s: %w", target, err)
+	}
+
+	parse_opts := html.ParseOptionEnableScripting(false)
+	node, err := html.ParseWithOptions(reader, parse_opts)
+	if err != nil {
+		return nil, "", fmt.Errorf("can't parse HTML from %s: %w", target, err)
+	}
+
+	return goquery.NewDocumentFromNode(node), finalURL, nil
+}
+
+// fetchAndExtract fetches target and runs readability extraction on
+// the resulting document.
+func fetchAndExtract(target string, timeout time.Duration, opt *readability.Option) (*FetchResult, error) {
+	start := time.Now()
+
+	document, finalURL, err := fetchDocument(target, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := readability.ExtractFromDocument(document, finalURL, opt)
+	if err != nil {
+		return nil, fmt.Errorf("can't extract article from %s: %w", finalURL, err)
+	}
+
+	return &FetchResult{
+		FinalURL: finalURL,
+		Content:  content,
+		Elapsed:  time.Since(start),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	readability "github.com/philipjkim/goreadability"
+	"golang.org/x/text/encoding/charmap"
+)
+
+func TestFetchAndExtractTranscodesNonUTF8Charset(t *testing.T) {
+	const plainTitle = "Café culture" // "Café culture"
+
+	encodedTitle, err := charmap.ISO8859_1.NewEncoder().String(plainTitle)
+	if err != nil {
+		t.Fatalf("can't encode fixture title: %v", err)
+	}
+
+	html := "<html><head><title>" + encodedTitle + "</title></head>" +
+		"<body><p>" + strings.Repeat(encodedTitle+" est un lieu agréable. ", 5) + "</p></body></html>"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=iso-8859-1")
+		w.Write([]byte(html))
+	}))
+	defer server.Close()
+
+	opt := readability.NewOption()
+	opt.LookupOpenGraphTags = false
+
+	result, err := fetchAndExtract(server.URL, 5*time.Second, opt)
+	if err != nil {
+		t.Fatalf("fetchAndExtract returned an error: %v", err)
+	}
+
+	if result.FinalURL != server.URL {
+		t.Errorf("FinalURL = %q, want %q", result.FinalURL, server.URL)
+	}
+
+	if !strings.Contains(result.Content.Title, plainTitle) {
+		t.Errorf("Title = %q, want it to contain the transcoded %q", result.Content.Title, plainTitle)
+	}
+}
+
+func TestFetchAndExtractFollowsRedirects(t *testing.T) {
+	body := "<html><head><title>Target</title></head><body><p>" +
+		strings.Repeat("This page is the redirect target. ", 10) + "</p></body></html>"
+
+	var targetServer *httptest.Server
+	targetServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirected" {
+			w.Write([]byte(body))
+			return
+		}
+
+		http.Redirect(w, r, targetServer.URL+"/redirected", http.StatusFound)
+	}))
+	defer targetServer.Close()
+
+	opt := readability.NewOption()
+
+	result, err := fetchAndExtract(targetServer.URL, 5*time.Second, opt)
+	if err != nil {
+		t.Fatalf("fetchAndExtract returned an error: %v", err)
+	}
+
+	want := targetServer.URL + "/redirected"
+	if result.FinalURL != want {
+		t.Errorf("FinalURL = %q, want %q", result.FinalURL, want)
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Metrics holds the standard suite of readability scores computed
+// from an article's plain text content.
+type Metrics struct {
+	FleschReadingEase  float64
+	FleschKincaidGrade float64
+	GunningFog         float64
+	SMOG               float64
+	ARI                float64
+	DaleChall          float64
+}
+
+var (
+	sentenceBoundaryRe = regexp.MustCompile(`[.!?]+(\s+|$)`)
+	abbreviationRe     = regexp.MustCompile(`(?i)\b(mr|mrs|ms|dr|prof|sr|jr|vs|etc|e\.g|i\.e|st|mt|ave)\.\s*$`)
+	wordRe             = regexp.MustCompile(`[\p{L}']+`)
+	vowelGroupRe       = regexp.MustCompile(`(?i)[aeiouy]+`)
+)
+
+// ComputeMetrics derives Metrics from plain text. It returns the zero
+// value when text has no words or no sentences, rather than dividing
+// by zero.
+func ComputeMetrics(text string) Metrics {
+	words := tokenizeWords(text)
+	sentenceCount := countSentences(text)
+
+	if len(words) == 0 || sentenceCount == 0 {
+		return Metrics{}
+	}
+
+	wordCount := float64(len(words))
+	sentenceCountF := float64(sentenceCount)
+
+	syllableCount := 0
+	complexCount := 0
+	difficultCount := 0
+	charCount := 0
+
+	for _, word := range words {
+		charCount += len([]rune(word))
+
+		syllables := countSyllables(word)
+		syllableCount += syllables
+
+		if isComplexWord(word, syllables) {
+			complexCount++
+		}
+
+		if !isFamiliarWord(word) {
+			difficultCount++
+		}
+	}
+
+	wordsPerSentence := wordCount / sentenceCountF
+	syllablesPerWord := float64(syllableCount) / wordCount
+	complexPerWord := float64(complexCount) / wordCount
+	charsPerWord := float64(charCount) / wordCount
+	difficultPercent := 100 * float64(difficultCount) / wordCount
+
+	daleChall := 0.1579*difficultPercent + 0.0496*wordsPerSentence
+	if difficultPercent > 5 {
+		daleChall += 3.6365
+	}
+
+	return Metrics{
+		FleschReadingEase:  206.835 - 1.015*wordsPerSentence - 84.6*syllablesPerWord,
+		FleschKincaidGrade: 0.39*wordsPerSentence + 11.8*syllablesPerWord - 15.59,
+		GunningFog:         0.4 * (wordsPerSentence + 100*complexPerWord),
+		SMOG:               1.0430*math.Sqrt(float64(complexCount)*(30/sentenceCountF)) + 3.1291,
+		ARI:                4.71*charsPerWord + 0.5*wordsPerSentence - 21.43,
+		DaleChall:          daleChall,
+	}
+}
+
+// countSentences splits text on '.', '!' and '?' while guarding
+// against common abbreviations (Mr., Dr., etc.) so they don't get
+// counted as sentence boundaries.
+func countSentences(text string) int {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return 0
+	}
+
+	count := 0
+	last := 0
+
+	for _, loc := range sentenceBoundaryRe.FindAllStringIndex(text, -1) {
+		if abbreviationRe.MatchString(text[last:loc[0]] + text[loc[0]:loc[0]+1]) {
+			continue
+		}
+
+		count++
+		last = loc[1]
+	}
+
+	if last < len(text) {
+		count++
+	}
+
+	return count
+}
+
+// tokenizeWords splits text into runs of Unicode letters (and
+// apostrophes, so contractions stay a single word).
+func tokenizeWords(text string) []string {
+	return wordRe.FindAllString(text, -1)
+}
+
+// countSyllables estimates a word's syllable count by counting vowel
+// groups, then adjusting for a silent trailing "e". Every word has at
+// least one syllable.
+func countSyllables(word string) int {
+	word = strings.ToLower(word)
+	groups := vowelGroupRe.FindAllString(word, -1)
+	count := len(groups)
+
+	if strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "le") && count > 1 {
+		count--
+	}
+
+	if count < 1 {
+		count = 1
+	}
+
+	return count
+}
+
+// isComplexWord reports whether a word counts as "complex" for the
+// Gunning Fog index: three or more syllables, excluding proper nouns
+// and words that only clear the threshold because of a common
+// inflectional suffix (-ed, -es, -ing).
+func isComplexWord(word string, syllables int) bool {
+	if word == "" {
+		return false
+	}
+
+	if unicode.IsUpper([]rune(word)[0]) {
+		return false
+	}
+
+	stripped := stripCommonSuffix(word)
+	if stripped != word {
+		syllables = countSyllables(stripped)
+	}
+
+	return syllables >= 3
+}
+
+// stripCommonSuffix removes a trailing -ing, -ed or -es, the
+// inflections Gunning Fog excludes from the syllable count.
+func stripCommonSuffix(word string) string {
+	lower := strings.ToLower(word)
+
+	switch {
+	case strings.HasSuffix(lower, "ing") && len(lower) > 5:
+		return word[:len(word)-3]
+	case strings.HasSuffix(lower, "ed") && len(lower) > 4:
+		return word[:len(word)-2]
+	case strings.HasSuffix(lower, "es") && len(lower) > 4:
+		return word[:len(word)-2]
+	default:
+		return word
+	}
+}
+
+// isFamiliarWord reports whether word is on the Dale-Chall familiar
+// word list.
+func isFamiliarWord(word string) bool {
+	_, ok := dalechallFamiliarWords[strings.ToLower(word)]
+	return ok
+}
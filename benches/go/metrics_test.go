@@ -0,0 +1,93 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComputeMetricsEmptyInput(t *testing.T) {
+	for _, text := range []string{"", "   ", "...", "!!!"} {
+		if got := ComputeMetrics(text); got != (Metrics{}) {
+			t.Errorf("ComputeMetrics(%q) = %+v, want zero value", text, got)
+		}
+	}
+}
+
+func TestComputeMetricsSingleSentence(t *testing.T) {
+	metrics := ComputeMetrics("The cat sat on the mat.")
+
+	if metrics == (Metrics{}) {
+		t.Fatalf("ComputeMetrics returned zero value for a valid single sentence")
+	}
+
+	if math.IsNaN(metrics.FleschReadingEase) || math.IsInf(metrics.FleschReadingEase, 0) {
+		t.Errorf("FleschReadingEase = %v, want a finite number", metrics.FleschReadingEase)
+	}
+}
+
+func TestCountSentences(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{"empty", "", 0},
+		{"single", "The cat sat on the mat.", 1},
+		{"three sentences", "One. Two! Three?", 3},
+		{"no trailing punctuation", "One. Two", 2},
+		{"abbreviation not a boundary", "Dr. Smith arrived. He left soon after.", 2},
+		{"multiple abbreviations", "Mr. and Mrs. Smith went home.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countSentences(tt.text); got != tt.want {
+				t.Errorf("countSentences(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountSyllables(t *testing.T) {
+	tests := []struct {
+		word string
+		want int
+	}{
+		{"cat", 1},
+		{"table", 2},
+		{"make", 1},
+		{"simple", 2},
+		{"readability", 5},
+		{"a", 1},
+		{"", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := countSyllables(tt.word); got != tt.want {
+				t.Errorf("countSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsComplexWord(t *testing.T) {
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"cat", false},
+		{"beautiful", true},
+		{"walking", false}, // 1 syllable once the -ing suffix is stripped
+		{"understanding", true},
+		{"Washington", false}, // proper noun, excluded regardless of syllable count
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := isComplexWord(tt.word, countSyllables(tt.word)); got != tt.want {
+				t.Errorf("isComplexWord(%q) = %v, want %v", tt.word, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -12,10 +13,26 @@ import (
 )
 
 func main() {
+	backend := flag.String("backend", "goreadability", "extractor backend to report in detail (goreadability or go-shiori)")
+	fetchURL := flag.String("fetch", "", "if set, fetch this URL over HTTP (with charset transcoding) instead of reading the local wikipedia.html")
+	flag.Parse()
+
 	url := "https://en.wikipedia.org/wiki/Particle_physics"
 	opt := readability.NewOption()
 	opt.LookupOpenGraphTags = false
 
+	if *fetchURL != "" {
+		result, err := fetchAndExtract(*fetchURL, 10*time.Second, opt)
+		if err != nil {
+			log.Fatalf("Can't fetch %s: %v", *fetchURL, err)
+		}
+
+		fmt.Printf("final url: %s\n", result.FinalURL)
+		fmt.Printf("title: %s\n", result.Content.Title)
+		fmt.Printf("fetch+extract: %s\n", result.Elapsed)
+		return
+	}
+
 	file, err := os.Open("../wikipedia.html")
 	if err != nil {
 		log.Fatalf("Can't open file: %v", err)
@@ -34,17 +51,80 @@ func main() {
 		log.Fatalf("Can't create document: %v", err)
 	}
 
-	start := time.Now()
+	if !IsReadable(document) {
+		fmt.Println("page doesn't look like an article, skipping extraction")
+		return
+	}
 
-	content, err := readability.ExtractFromDocument(document, url, opt)
+	extractOpt := &Options{LookupOpenGraphTags: opt.LookupOpenGraphTags}
 
-	elapsed := time.Since(start)
+	var article *Article
 
-	if err != nil {
-		log.Fatalf("Can't extract article: %v", err)
+	for _, name := range []string{"goreadability", "go-shiori"} {
+		ext, ok := extractors[name]
+		if !ok {
+			log.Fatalf("unknown backend: %s", name)
+		}
+
+		start := time.Now()
+		result, err := ext.Extract(document, url, extractOpt)
+		elapsed := time.Since(start)
+
+		if err != nil {
+			log.Printf("[%s] can't extract article: %v", name, err)
+			continue
+		}
+
+		fmt.Printf("[%s] %s\n", name, elapsed)
+
+		if name == *backend {
+			article = result
+		}
 	}
 
-	fmt.Printf("content: %s\n", content.Description)
+	if article == nil {
+		log.Fatalf("backend %q produced no article", *backend)
+	}
+
+	fmt.Printf("title: %s\n", article.Title)
+	fmt.Printf("byline: %s\n", article.Byline)
+	fmt.Printf("excerpt: %s\n", article.Excerpt)
+	fmt.Printf("site name: %s\n", article.SiteName)
+	fmt.Printf("length: %d\n", article.Length)
+	fmt.Printf("flesch reading ease: %.1f\n", article.Metrics.FleschReadingEase)
+	fmt.Printf("flesch-kincaid grade: %.1f\n", article.Metrics.FleschKincaidGrade)
+	fmt.Printf("gunning fog: %.1f\n", article.Metrics.GunningFog)
+	fmt.Printf("smog: %.1f\n", article.Metrics.SMOG)
+	fmt.Printf("ari: %.1f\n", article.Metrics.ARI)
+	fmt.Printf("dale-chall: %.1f\n", article.Metrics.DaleChall)
+
+	runBatchBenchmark(opt)
+}
+
+// runBatchBenchmark extracts the same local file repeatedly through
+// BatchExtract and reports aggregate throughput, so the harness
+// doubles as a rough concurrency benchmark.
+func runBatchBenchmark(opt *readability.Option) {
+	const copies = 20
+
+	inputs := make([]Input, copies)
+	for i := range inputs {
+		inputs[i] = Input{FilePath: "../wikipedia.html"}
+	}
+
+	start := time.Now()
+	results := BatchExtract(inputs, BatchOptions{
+		Timeout:           10 * time.Second,
+		ReadabilityOption: opt,
+	})
+	elapsed := time.Since(start)
+
+	ok := 0
+	for _, result := range results {
+		if result.Err == nil {
+			ok++
+		}
+	}
 
-	fmt.Printf("readability.go: %s\n", elapsed)
+	fmt.Printf("batch: %d/%d ok in %s (%.1f pages/sec)\n", ok, len(results), elapsed, float64(len(results))/elapsed.Seconds())
 }
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	minParagraphLength = 140
+	minScore           = 20
+)
+
+var (
+	unlikelyCandidatesRe   = regexp.MustCompile(`(?i)banner|combx|comment|community|disqus|extra|foot|header|menu|modal|related|remark|rss|share|shoutbox|sidebar|skyscraper|sponsor|ad-break|agegate|pagination|pager|popup|yom-remote`)
+	okMaybeItsACandidateRe = regexp.MustCompile(`(?i)and|article|body|column|main|shadow`)
+)
+
+// IsReadable runs a cheap heuristic pass over doc and reports whether
+// it looks like an article worth the cost of full extraction. It
+// mirrors Mozilla's isProbablyReaderable: count <p> (and <pre>) nodes
+// long enough to matter, skip ones that look like chrome based on
+// their class/id, and accumulate sqrt(textLength-minParagraphLength)
+// per qualifying paragraph until the total clears minScore.
+func IsReadable(doc *goquery.Document) bool {
+	score := 0.0
+
+	doc.Find("p, pre").EachWithBreak(func(_ int, node *goquery.Selection) bool {
+		class, _ := node.Attr("class")
+		id, _ := node.Attr("id")
+
+		if unlikelyCandidatesRe.MatchString(class+id) && !okMaybeItsACandidateRe.MatchString(class+id) {
+			return true
+		}
+
+		textLength := len(strings.TrimSpace(node.Text()))
+		if textLength < minParagraphLength {
+			return true
+		}
+
+		score += math.Sqrt(float64(textLength - minParagraphLength))
+
+		return score <= minScore
+	})
+
+	return score > minScore
+}
+
+// isURLReadable fetches target and reports whether the resulting
+// document looks like an article, without running full extraction.
+func isURLReadable(target string, timeout time.Duration) (bool, error) {
+	document, _, err := fetchDocument(target, timeout)
+	if err != nil {
+		return false, err
+	}
+
+	return IsReadable(document), nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	shiori "github.com/go-shiori/go-readability"
+)
+
+// shioriExtractor extracts using go-shiori/go-readability, which
+// tracks Mozilla's Readability.js algorithm more closely than
+// goreadability does.
+type shioriExtractor struct{}
+
+func (shioriExtractor) Extract(doc *goquery.Document, pageURL string, opt *Options) (*Article, error) {
+	// go-shiori works from an html.Node tree of its own, not a
+	// goquery.Document, so round-trip through the serialized HTML to
+	// keep the Extractor interface backend-agnostic.
+	rawHTML, err := doc.Html()
+	if err != nil {
+		return nil, fmt.Errorf("can't serialize document: %w", err)
+	}
+
+	parsedURL, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url %s: %w", pageURL, err)
+	}
+
+	article, err := shiori.FromReader(strings.NewReader(rawHTML), parsedURL)
+	if err != nil {
+		return nil, fmt.Errorf("can't extract article from %s: %w", pageURL, err)
+	}
+
+	return &Article{
+		Title:       article.Title,
+		Byline:      article.Byline,
+		Excerpt:     article.Excerpt,
+		SiteName:    article.SiteName,
+		Content:     article.Content,
+		TextContent: article.TextContent,
+		Length:      article.Length,
+		Metrics:     ComputeMetrics(article.TextContent),
+	}, nil
+}